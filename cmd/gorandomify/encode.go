@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Encoder streams generated documents to an underlying io.Writer according
+// to one of the supported -format values.
+type Encoder interface {
+	Encode(doc map[string]interface{}) error
+	Close() error
+}
+
+// NewEncoder returns the Encoder for the given -format value: "json-array"
+// (the default), "ndjson", or "jsonl.gz".
+func NewEncoder(format string, w io.Writer) (Encoder, error) {
+	switch format {
+	case "", "json-array":
+		return &jsonArrayEncoder{w: w}, nil
+	case "ndjson":
+		return &ndjsonEncoder{w: bufio.NewWriter(w)}, nil
+	case "jsonl.gz":
+		gz := gzip.NewWriter(w)
+		return &ndjsonEncoder{w: bufio.NewWriter(gz), closer: gz}, nil
+	}
+	return nil, fmt.Errorf("unsupported format: %s", format)
+}
+
+// jsonArrayEncoder writes each document as an element of a single top-level
+// JSON array, without buffering the whole array in memory.
+type jsonArrayEncoder struct {
+	w     io.Writer
+	wrote bool
+}
+
+func (e *jsonArrayEncoder) Encode(doc map[string]interface{}) error {
+	prefix := ",\n  "
+	if !e.wrote {
+		prefix = "[\n  "
+		e.wrote = true
+	}
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(e.w, "%s%s", prefix, encoded)
+	return err
+}
+
+func (e *jsonArrayEncoder) Close() error {
+	if !e.wrote {
+		_, err := io.WriteString(e.w, "[]\n")
+		return err
+	}
+	_, err := io.WriteString(e.w, "\n]\n")
+	return err
+}
+
+// ndjsonEncoder writes one JSON document per line, optionally through a
+// gzip closer for the "jsonl.gz" format.
+type ndjsonEncoder struct {
+	w      *bufio.Writer
+	closer io.Closer
+}
+
+func (e *ndjsonEncoder) Encode(doc map[string]interface{}) error {
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	if _, err := e.w.Write(encoded); err != nil {
+		return err
+	}
+	return e.w.WriteByte('\n')
+}
+
+func (e *ndjsonEncoder) Close() error {
+	if err := e.w.Flush(); err != nil {
+		return err
+	}
+	if e.closer != nil {
+		return e.closer.Close()
+	}
+	return nil
+}
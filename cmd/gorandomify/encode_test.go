@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestJSONArrayEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	enc, err := NewEncoder("json-array", &buf)
+	if err != nil {
+		t.Fatalf("NewEncoder unexpected error: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := enc.Encode(map[string]interface{}{"n": i}); err != nil {
+			t.Fatalf("Encode unexpected error: %v", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Close unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(buf.String(), "[") || !strings.HasSuffix(strings.TrimSpace(buf.String()), "]") {
+		t.Errorf("expected a JSON array, got %s", buf.String())
+	}
+}
+
+func TestNDJSONEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	enc, err := NewEncoder("ndjson", &buf)
+	if err != nil {
+		t.Fatalf("NewEncoder unexpected error: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := enc.Encode(map[string]interface{}{"n": i}); err != nil {
+			t.Fatalf("Encode unexpected error: %v", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Close unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Errorf("expected 3 lines, got %d", len(lines))
+	}
+}
+
+func TestNewEncoderUnsupportedFormat(t *testing.T) {
+	if _, err := NewEncoder("bogus", &bytes.Buffer{}); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
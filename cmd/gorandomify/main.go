@@ -0,0 +1,215 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"time"
+
+	"github.com/rohanchavan1918/gorandomify/pkg/gorandomify"
+)
+
+// Version is the tool version recorded in the manifest written alongside
+// each generated file, so a fixture can be traced back to the build that
+// produced it.
+const Version = "0.2.0"
+
+type Color string
+
+const (
+	ColorBlack  Color = "\u001b[30m"
+	ColorRed    Color = "\u001b[31m"
+	ColorGreen  Color = "\u001b[32m"
+	ColorYellow Color = "\u001b[33m"
+	ColorBlue   Color = "\u001b[34m"
+	ColorReset  Color = "\u001b[0m"
+)
+
+// documentGenerator is satisfied by anything that can produce independent
+// documents on demand, letting generateBulk stay agnostic to whether
+// documents come from a template (templateGenerator) or a JSON Schema
+// (*gorandomify.SchemaGenerator).
+type documentGenerator interface {
+	Generate() (map[string]interface{}, error)
+}
+
+// templateGenerator adapts gorandomify.Generator.GenerateFromMap, which
+// takes the decoded template as an argument, to the zero-argument
+// documentGenerator interface generateBulk expects.
+type templateGenerator struct {
+	g        *gorandomify.Generator
+	template map[string]interface{}
+}
+
+func (t *templateGenerator) Generate() (map[string]interface{}, error) {
+	return t.g.GenerateFromMap(t.template)
+}
+
+func main() {
+	sourcePath := flag.String("t", "", "Source of template file (.json, .yaml/.yml, .hcl)")
+	schemaPath := flag.String("schema", "", "Source of a JSON Schema file to generate a conforming document from, instead of a template")
+	destinationPath := flag.String("o", "", "Destination path")
+	seedFlag := flag.Int64("seed", 0, "Seed for deterministic generation (defaults to the current time)")
+	count := flag.Int("n", 1, "Number of documents to generate")
+	format := flag.String("format", "json-array", "Output format for n>1: json-array, ndjson, jsonl.gz")
+	sinkURI := flag.String("sink", "", "Where to send generated documents: stdout (default), file://path, http://host/endpoint, kafka://broker/topic")
+	flag.Parse()
+
+	if *sourcePath == "" && *schemaPath == "" {
+		colorize(ColorRed, "template file not passed")
+		return
+	}
+
+	seed := *seedFlag
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	r := rand.New(rand.NewSource(seed))
+
+	var generator documentGenerator
+	if *schemaPath != "" {
+		plan, err := ioutil.ReadFile(*schemaPath)
+		if err != nil {
+			colorize(ColorRed, err.Error())
+			return
+		}
+		schemaGenerator, err := gorandomify.NewSchemaGeneratorFromFile(plan, r)
+		if err != nil {
+			colorize(ColorRed, err.Error())
+			return
+		}
+		generator = schemaGenerator
+	} else {
+		plan, err := ioutil.ReadFile(*sourcePath)
+		if err != nil {
+			colorize(ColorRed, err.Error())
+			return
+		}
+		template, err := gorandomify.LoadTemplate(*sourcePath, plan)
+		if err != nil {
+			colorize(ColorRed, err.Error())
+			return
+		}
+		generator = &templateGenerator{g: gorandomify.New(gorandomify.WithRand(r)), template: template}
+	}
+
+	if *count > 1 || *sinkURI != "" {
+		if err := generateBulk(generator, *count, *format, *sinkURI, *destinationPath); err != nil {
+			colorize(ColorRed, "Error: "+err.Error())
+			return
+		}
+
+		if *destinationPath != "" && *sinkURI == "" {
+			written, err := ioutil.ReadFile(*destinationPath)
+			if err != nil {
+				colorize(ColorRed, "Error reading generated file for manifest: "+err.Error())
+				return
+			}
+			if err := writeManifest(*destinationPath, seed, written); err != nil {
+				colorize(ColorRed, "Error writing manifest: "+err.Error())
+				return
+			}
+		}
+		return
+	}
+
+	copiedData, err := generator.Generate()
+	if err != nil {
+		colorize(ColorRed, "Error: "+err.Error())
+		return
+	}
+
+	updatedJSON, err := json.MarshalIndent(copiedData, "", "  ")
+	if err != nil {
+		colorize(ColorRed, "Error: "+err.Error())
+		return
+	}
+
+	if *destinationPath != "" {
+		if err := writeToFile(*destinationPath, updatedJSON); err != nil {
+			colorize(ColorRed, "Error: "+err.Error())
+			return
+		}
+
+		if err := writeManifest(*destinationPath, seed, updatedJSON); err != nil {
+			colorize(ColorRed, "Error writing manifest: "+err.Error())
+			return
+		}
+
+		colorize(ColorGreen, "JSON generated successfully: "+*destinationPath)
+	} else {
+		fmt.Println(string(updatedJSON))
+	}
+
+}
+
+// generateBulk streams count independently-generated documents from
+// generator through the sink named by sinkURI (defaulting to destinationPath
+// as a file, or stdout if neither is set), without buffering more than one
+// document in memory at a time.
+func generateBulk(generator documentGenerator, count int, format, sinkURI, destinationPath string) error {
+	if sinkURI == "" && destinationPath != "" {
+		sinkURI = "file://" + destinationPath
+	}
+
+	sink, err := NewSink(sinkURI, format)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < count; i++ {
+		doc, err := generator.Generate()
+		if err != nil {
+			return fmt.Errorf("document %d: %w", i, err)
+		}
+		if err := sink.Write(doc); err != nil {
+			return fmt.Errorf("document %d: %w", i, err)
+		}
+	}
+
+	if err := sink.Close(); err != nil {
+		return err
+	}
+
+	colorize(ColorGreen, fmt.Sprintf("Generated %d documents", count))
+	return nil
+}
+
+func colorize(color Color, message string) {
+	fmt.Println(string(color), message, string(ColorReset))
+}
+
+func writeToFile(filename string, data []byte) error {
+	return ioutil.WriteFile(filename, data, 0644)
+}
+
+// manifest records how a generated file was produced, so the exact same
+// output can be reproduced later by passing the same seed back in.
+type manifest struct {
+	Seed    int64  `json:"seed"`
+	Version string `json:"version"`
+	SHA256  string `json:"sha256"`
+}
+
+// writeManifest writes a sidecar "<filename>.manifest.json" file alongside
+// the generated output, recording the seed, tool version, and a checksum
+// of the generated bytes.
+func writeManifest(filename string, seed int64, data []byte) error {
+	sum := sha256.Sum256(data)
+	m := manifest{
+		Seed:    seed,
+		Version: Version,
+		SHA256:  hex.EncodeToString(sum[:]),
+	}
+
+	encoded, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeToFile(filename+".manifest.json", encoded)
+}
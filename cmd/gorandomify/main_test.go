@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestWriteManifest(t *testing.T) {
+	filename := "test_manifest_output.json"
+	data := []byte(`{"key": "value"}`)
+	defer os.Remove(filename + ".manifest.json")
+
+	if err := writeManifest(filename, 42, data); err != nil {
+		t.Fatalf("writeManifest unexpected error: %v", err)
+	}
+
+	raw, err := ioutil.ReadFile(filename + ".manifest.json")
+	if err != nil {
+		t.Fatalf("failed to read manifest: %v", err)
+	}
+
+	var m manifest
+	if err := json.Unmarshal(raw, &m); err != nil {
+		t.Fatalf("failed to unmarshal manifest: %v", err)
+	}
+
+	if m.Seed != 42 {
+		t.Errorf("expected seed 42, got %d", m.Seed)
+	}
+	if m.Version != Version {
+		t.Errorf("expected version %s, got %s", Version, m.Version)
+	}
+	if len(m.SHA256) != 64 {
+		t.Errorf("expected a 64-character hex SHA-256, got %d characters", len(m.SHA256))
+	}
+}
+
+func TestWriteToFile(t *testing.T) {
+	filename := "test_output.json"
+	data := []byte(`{"key": "value"}`)
+
+	err := writeToFile(filename, data)
+	if err != nil {
+		t.Errorf("writeToFile(%s) unexpected error: %v", filename, err)
+	}
+
+	readData, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Errorf("ioutil.ReadFile(%s) unexpected error: %v", filename, err)
+	}
+
+	if string(readData) != string(data) {
+		t.Errorf("Expected file content %s, got %s", data, readData)
+	}
+
+	os.Remove(filename)
+}
+
+func TestMainFunction(t *testing.T) {
+	sourceFilename := "test_input.json"
+	destinationFilename := "test_output.json"
+
+	sourceData := `{
+		"key1": "$UUID",
+		"key2": "$INT(1:10)",
+		"key3": "$CHAR(5)"
+	}`
+
+	err := ioutil.WriteFile(sourceFilename, []byte(sourceData), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write test input file: %v", err)
+	}
+	defer os.Remove(sourceFilename)
+
+	// Redirect os.Args to simulate command-line arguments
+	os.Args = []string{"cmd", "-t", sourceFilename, "-o", destinationFilename}
+
+	main()
+
+	outputData, err := ioutil.ReadFile(destinationFilename)
+	if err != nil {
+		t.Fatalf("Failed to read test output file: %v", err)
+	}
+	defer os.Remove(destinationFilename)
+
+	var outputJSON map[string]interface{}
+	if err := json.Unmarshal(outputData, &outputJSON); err != nil {
+		t.Fatalf("Failed to unmarshal output JSON: %v", err)
+	}
+
+	if outputJSON["key1"] == "$UUID" || outputJSON["key2"] == "$INT(1:10)" || outputJSON["key3"] == "$CHAR(5)" {
+		t.Error("Expected keys to be updated with new values, but they weren't")
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	kafka "github.com/segmentio/kafka-go"
+)
+
+// Sink is where generated documents are delivered: a file, stdout, an HTTP
+// endpoint (one POST per document), or a Kafka topic (one message per
+// document).
+type Sink interface {
+	Write(doc map[string]interface{}) error
+	Close() error
+}
+
+// NewSink parses a -sink URI and returns the matching Sink. Supported
+// forms: "" or "stdout", "file://path", "http://host/endpoint" (or https),
+// and "kafka://broker/topic". format is only used by the file/stdout sinks,
+// which stream through an Encoder.
+func NewSink(sinkURI, format string) (Sink, error) {
+	if sinkURI == "" || sinkURI == "stdout" {
+		enc, err := NewEncoder(format, os.Stdout)
+		if err != nil {
+			return nil, err
+		}
+		return &encodedSink{enc: enc}, nil
+	}
+
+	parsed, err := url.Parse(sinkURI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sink URI: %w", err)
+	}
+
+	switch parsed.Scheme {
+	case "file":
+		// A relative path after "file://" (e.g. "file://out.ndjson") is
+		// parsed by url.Parse as Host "out.ndjson" with an empty Path, so
+		// the file path is Host+Path rather than Path alone.
+		path := parsed.Host + parsed.Path
+		f, err := os.Create(path)
+		if err != nil {
+			return nil, err
+		}
+		enc, err := NewEncoder(format, f)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &encodedSink{enc: enc, closer: f}, nil
+	case "http", "https":
+		return &httpSink{endpoint: sinkURI, client: http.DefaultClient}, nil
+	case "kafka":
+		topic := strings.TrimPrefix(parsed.Path, "/")
+		return &kafkaSink{writer: &kafka.Writer{Addr: kafka.TCP(parsed.Host), Topic: topic}}, nil
+	}
+	return nil, fmt.Errorf("unsupported sink scheme: %s", parsed.Scheme)
+}
+
+// encodedSink writes documents through an Encoder; used by the file and
+// stdout sinks, where -format applies.
+type encodedSink struct {
+	enc    Encoder
+	closer interface{ Close() error }
+}
+
+func (s *encodedSink) Write(doc map[string]interface{}) error {
+	return s.enc.Encode(doc)
+}
+
+func (s *encodedSink) Close() error {
+	if err := s.enc.Close(); err != nil {
+		return err
+	}
+	if s.closer != nil {
+		return s.closer.Close()
+	}
+	return nil
+}
+
+// httpSink POSTs each document as its own JSON request.
+type httpSink struct {
+	endpoint string
+	client   *http.Client
+}
+
+func (s *httpSink) Write(doc map[string]interface{}) error {
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.endpoint, "application/json", bytes.NewReader(encoded))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("sink POST %s: unexpected status %s", s.endpoint, resp.Status)
+	}
+	return nil
+}
+
+func (s *httpSink) Close() error {
+	return nil
+}
+
+// kafkaSink produces each document as its own Kafka message.
+type kafkaSink struct {
+	writer *kafka.Writer
+}
+
+func (s *kafkaSink) Write(doc map[string]interface{}) error {
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return s.writer.WriteMessages(context.Background(), kafka.Message{Value: encoded})
+}
+
+func (s *kafkaSink) Close() error {
+	return s.writer.Close()
+}
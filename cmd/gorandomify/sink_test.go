@@ -0,0 +1,38 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestNewSinkFile(t *testing.T) {
+	filename := "test_sink_output.ndjson"
+	defer os.Remove(filename)
+
+	sink, err := NewSink("file://"+filename, "ndjson")
+	if err != nil {
+		t.Fatalf("NewSink unexpected error: %v", err)
+	}
+	if err := sink.Write(map[string]interface{}{"n": 1}); err != nil {
+		t.Fatalf("Write unexpected error: %v", err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close unexpected error: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("failed to read sink output: %v", err)
+	}
+	if !strings.Contains(string(data), `"n":1`) {
+		t.Errorf("expected written document in output, got %s", data)
+	}
+}
+
+func TestNewSinkUnsupportedScheme(t *testing.T) {
+	if _, err := NewSink("bogus://somewhere", ""); err == nil {
+		t.Error("expected an error for an unsupported sink scheme")
+	}
+}
@@ -0,0 +1,116 @@
+package gorandomify
+
+import (
+	"encoding/json"
+	"io"
+	"iter"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// Generator resolves $TOKEN-style template values into random data. The
+// zero value is not usable; construct one with New. A Generator is safe to
+// reuse across many calls to produce independent documents from the same
+// or different templates.
+type Generator struct {
+	updaters map[string]Updater
+	rng      *rand.Rand
+}
+
+// Option configures a Generator constructed by New.
+type Option func(*Generator)
+
+// WithSeed seeds the Generator's random source deterministically; the same
+// seed and template always produce the same sequence of documents.
+func WithSeed(seed int64) Option {
+	return func(g *Generator) { g.rng = rand.New(rand.NewSource(seed)) }
+}
+
+// WithRand uses r as the Generator's random source, letting callers share a
+// single *rand.Rand across generators or substitute their own source.
+func WithRand(r *rand.Rand) Option {
+	return func(g *Generator) { g.rng = r }
+}
+
+// New returns a Generator with the built-in token set (uuid, int, char,
+// name, email, address, phone, date, lorem, ip, url, creditcard) and a
+// time-seeded random source, customized by opts.
+func New(opts ...Option) *Generator {
+	g := &Generator{
+		updaters: defaultUpdaters(),
+		rng:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// RegisterUpdater adds or overrides the Updater invoked for $NAME-style
+// tokens, where name is matched case-insensitively (e.g. "ACCOUNTID" for a
+// "$ACCOUNTID" token). It lets callers add project-specific tokens without
+// forking this package.
+func (g *Generator) RegisterUpdater(name string, u Updater) {
+	g.updaters[strings.ToLower(name)] = u
+}
+
+// GenerateFromMap produces one document by resolving template's tokens
+// against a deep copy, leaving template itself untouched. It's the shared
+// step behind GenerateFromTemplate and GenerateN, and the one callers that
+// already have a decoded template (e.g. from YAML or HCL) should use
+// directly.
+func (g *Generator) GenerateFromMap(template map[string]interface{}) (map[string]interface{}, error) {
+	data := deepCopyValue(template).(map[string]interface{})
+	copied := copyData(data)
+
+	ctx := newGenerationContext(g.updaters, g.rng)
+	traverseAndUpdate(data, copied, "", ctx)
+	if err := ctx.resolveRefs(); err != nil {
+		return nil, err
+	}
+	return copied, nil
+}
+
+// GenerateFromTemplate decodes r as a JSON template and produces one
+// document from it. For YAML or HCL input, decode with LoadTemplate first
+// and call GenerateFromMap instead.
+func (g *Generator) GenerateFromTemplate(r io.Reader) (map[string]interface{}, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var template map[string]interface{}
+	if err := json.Unmarshal(data, &template); err != nil {
+		return nil, err
+	}
+	return g.GenerateFromMap(template)
+}
+
+// GenerateN decodes r as a JSON template once and lazily yields n
+// independent documents generated from it. Decoding or generation errors
+// stop the sequence early, since iter.Seq has no error channel of its own.
+func (g *Generator) GenerateN(r io.Reader, n int) iter.Seq[map[string]interface{}] {
+	return func(yield func(map[string]interface{}) bool) {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return
+		}
+
+		var template map[string]interface{}
+		if err := json.Unmarshal(data, &template); err != nil {
+			return
+		}
+
+		for i := 0; i < n; i++ {
+			doc, err := g.GenerateFromMap(template)
+			if err != nil {
+				return
+			}
+			if !yield(doc) {
+				return
+			}
+		}
+	}
+}
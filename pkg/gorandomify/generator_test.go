@@ -0,0 +1,88 @@
+package gorandomify
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestGeneratorGenerateFromMapIsIndependent(t *testing.T) {
+	template := map[string]interface{}{
+		"id": "$UUID",
+	}
+	g := New(WithRand(testRand()))
+
+	first, err := g.GenerateFromMap(template)
+	if err != nil {
+		t.Fatalf("GenerateFromMap unexpected error: %v", err)
+	}
+	second, err := g.GenerateFromMap(template)
+	if err != nil {
+		t.Fatalf("GenerateFromMap unexpected error: %v", err)
+	}
+
+	if first["id"] == second["id"] {
+		t.Error("expected successive GenerateFromMap calls to produce independent ids")
+	}
+	if template["id"] != "$UUID" {
+		t.Error("expected GenerateFromMap to leave the original template untouched")
+	}
+}
+
+func TestGeneratorGenerateFromTemplateIsDeterministicWithSameSeed(t *testing.T) {
+	template := strings.NewReader(`{"id": "$UUID", "age": "$INT(1:100)"}`)
+	other := strings.NewReader(`{"id": "$UUID", "age": "$INT(1:100)"}`)
+
+	a := New(WithSeed(7))
+	b := New(WithSeed(7))
+
+	docA, err := a.GenerateFromTemplate(template)
+	if err != nil {
+		t.Fatalf("GenerateFromTemplate unexpected error: %v", err)
+	}
+	docB, err := b.GenerateFromTemplate(other)
+	if err != nil {
+		t.Fatalf("GenerateFromTemplate unexpected error: %v", err)
+	}
+
+	if docA["id"] != docB["id"] || docA["age"] != docB["age"] {
+		t.Errorf("expected generators seeded alike to agree, got %v and %v", docA, docB)
+	}
+}
+
+func TestGeneratorGenerateN(t *testing.T) {
+	g := New(WithSeed(3))
+	ids := map[string]bool{}
+
+	count := 0
+	for doc := range g.GenerateN(strings.NewReader(`{"id": "$UUID"}`), 5) {
+		ids[doc["id"].(string)] = true
+		count++
+	}
+
+	if count != 5 {
+		t.Errorf("expected GenerateN to yield 5 documents, got %d", count)
+	}
+	if len(ids) != 5 {
+		t.Errorf("expected 5 independently generated ids, got %d", len(ids))
+	}
+}
+
+type accountIDUpdater struct{}
+
+func (accountIDUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return "acct_test", nil
+}
+
+func TestGeneratorRegisterUpdater(t *testing.T) {
+	g := New(WithRand(testRand()))
+	g.RegisterUpdater("ACCOUNTID", accountIDUpdater{})
+
+	doc, err := g.GenerateFromMap(map[string]interface{}{"account": "$ACCOUNTID"})
+	if err != nil {
+		t.Fatalf("GenerateFromMap unexpected error: %v", err)
+	}
+	if doc["account"] != "acct_test" {
+		t.Errorf("expected registered $ACCOUNTID updater to run, got %v", doc["account"])
+	}
+}
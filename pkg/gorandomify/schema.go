@@ -0,0 +1,227 @@
+package gorandomify
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// jsonSchema is the subset of JSON Schema (draft 2020-12) that -schema mode
+// understands: type, format, numeric/array bounds, enum, and the oneOf/
+// anyOf branch keywords.
+type jsonSchema struct {
+	Type       interface{}            `json:"type"`
+	Format     string                 `json:"format"`
+	Minimum    *float64               `json:"minimum"`
+	Maximum    *float64               `json:"maximum"`
+	MinItems   *int                   `json:"minItems"`
+	MaxItems   *int                   `json:"maxItems"`
+	Items      *jsonSchema            `json:"items"`
+	Properties map[string]*jsonSchema `json:"properties"`
+	Enum       []interface{}          `json:"enum"`
+	OneOf      []*jsonSchema          `json:"oneOf"`
+	AnyOf      []*jsonSchema          `json:"anyOf"`
+}
+
+// SchemaGenerator produces documents conforming to a JSON Schema instead of
+// a $TOKEN-sprinkled template, reusing the same random-value generators for
+// string formats that already have one (uuid, email, date, ...).
+type SchemaGenerator struct {
+	schema *jsonSchema
+	rng    *rand.Rand
+}
+
+// NewSchemaGeneratorFromFile parses data as a JSON Schema document and
+// returns a SchemaGenerator for it.
+func NewSchemaGeneratorFromFile(data []byte, rng *rand.Rand) (*SchemaGenerator, error) {
+	var schema jsonSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+	return &SchemaGenerator{schema: &schema, rng: rng}, nil
+}
+
+// Generate walks the schema and returns a document conforming to it. The
+// schema root must describe a JSON object, matching what the rest of the
+// generation pipeline (templates, sinks) expects.
+func (g *SchemaGenerator) Generate() (map[string]interface{}, error) {
+	value, err := generateFromSchema(g.schema, g.rng)
+	if err != nil {
+		return nil, err
+	}
+	doc, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("schema root must be type object to produce a document")
+	}
+	return doc, nil
+}
+
+func generateFromSchema(schema *jsonSchema, r *rand.Rand) (interface{}, error) {
+	if len(schema.Enum) > 0 {
+		return schema.Enum[r.Intn(len(schema.Enum))], nil
+	}
+	if len(schema.OneOf) > 0 {
+		return generateFromSchema(schema.OneOf[r.Intn(len(schema.OneOf))], r)
+	}
+	if len(schema.AnyOf) > 0 {
+		return generateFromSchema(schema.AnyOf[r.Intn(len(schema.AnyOf))], r)
+	}
+
+	switch schemaTypeName(schema.Type, r) {
+	case "string":
+		return generateSchemaString(schema, r)
+	case "integer":
+		return generateSchemaInt(schema, r)
+	case "number":
+		return generateSchemaNumber(schema, r)
+	case "boolean":
+		return r.Intn(2) == 1, nil
+	case "array":
+		return generateSchemaArray(schema, r)
+	case "object":
+		return generateSchemaObject(schema, r)
+	}
+	return nil, fmt.Errorf("unsupported schema type: %v", schema.Type)
+}
+
+// schemaTypeName resolves the "type" keyword, which JSON Schema allows to
+// be a single string or an array of candidate types; a random candidate is
+// picked in the latter case.
+func schemaTypeName(t interface{}, r *rand.Rand) string {
+	switch v := t.(type) {
+	case string:
+		return v
+	case []interface{}:
+		if len(v) == 0 {
+			return ""
+		}
+		if name, ok := v[r.Intn(len(v))].(string); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// generateSchemaString dispatches on the "format" keyword to an existing
+// random-value generator, falling back to an opaque random string.
+func generateSchemaString(schema *jsonSchema, r *rand.Rand) (interface{}, error) {
+	switch schema.Format {
+	case "uuid":
+		id, err := uuid.NewRandomFromReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return id.String(), nil
+	case "email":
+		return randomEmail(r), nil
+	case "date":
+		return randomDate("", r)
+	case "date-time":
+		date, err := randomDate("", r)
+		if err != nil {
+			return nil, err
+		}
+		return date + "T00:00:00Z", nil
+	case "ipv4":
+		return randomIP("v4", r)
+	case "ipv6":
+		return randomIP("v6", r)
+	case "uri":
+		return randomURL(r), nil
+	}
+	return getRandomStrNlen(10, r), nil
+}
+
+// schemaBounds resolves the "minimum"/"maximum" keywords, falling back to a
+// sensible default range when either is absent. The default upper is
+// clamped up to lower (e.g. {"minimum": 20000} with no "maximum" shouldn't
+// leave upper below lower), the default lower is likewise clamped down to an
+// explicit maximum, and an explicit minimum > maximum is an error.
+func schemaBounds(schema *jsonSchema, defaultLower, defaultUpper int) (int, int, error) {
+	lower, upper := defaultLower, defaultUpper
+	if schema.Minimum != nil {
+		lower = int(*schema.Minimum)
+	}
+	if upper < lower {
+		upper = lower
+	}
+	if schema.Maximum != nil {
+		upper = int(*schema.Maximum)
+		if schema.Minimum == nil && lower > upper {
+			lower = upper
+		}
+	}
+	if lower > upper {
+		return 0, 0, fmt.Errorf("invalid schema bounds: minimum %d > maximum %d", lower, upper)
+	}
+	return lower, upper, nil
+}
+
+func generateSchemaInt(schema *jsonSchema, r *rand.Rand) (int, error) {
+	lower, upper, err := schemaBounds(schema, 0, 10000)
+	if err != nil {
+		return 0, err
+	}
+	return lower + r.Intn(upper-lower+1), nil
+}
+
+func generateSchemaNumber(schema *jsonSchema, r *rand.Rand) (float64, error) {
+	lower, upper, err := schemaBounds(schema, 0, 10000)
+	if err != nil {
+		return 0, err
+	}
+	return float64(lower) + r.Float64()*float64(upper-lower), nil
+}
+
+// generateSchemaArray drives repetition from "minItems"/"maxItems" (default
+// 1-5 elements), generating each element independently from "items".
+func generateSchemaArray(schema *jsonSchema, r *rand.Rand) (interface{}, error) {
+	if schema.Items == nil {
+		return []interface{}{}, nil
+	}
+
+	minItems, maxItems := 1, 5
+	if schema.MinItems != nil {
+		minItems = *schema.MinItems
+	}
+	if schema.MaxItems != nil {
+		maxItems = *schema.MaxItems
+	}
+	if minItems > maxItems {
+		return nil, fmt.Errorf("invalid array bounds: minItems %d > maxItems %d", minItems, maxItems)
+	}
+
+	items := make([]interface{}, minItems+r.Intn(maxItems-minItems+1))
+	for i := range items {
+		item, err := generateFromSchema(schema.Items, r)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+// generateSchemaObject generates each property in sorted-name order so that
+// RNG consumption (and thus the output for a given seed) doesn't depend on
+// Go's randomized map iteration order.
+func generateSchemaObject(schema *jsonSchema, r *rand.Rand) (interface{}, error) {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	obj := make(map[string]interface{}, len(schema.Properties))
+	for _, name := range names {
+		val, err := generateFromSchema(schema.Properties[name], r)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		obj[name] = val
+	}
+	return obj, nil
+}
@@ -0,0 +1,68 @@
+package gorandomify
+
+import "testing"
+
+func TestSchemaGeneratorGenerateObject(t *testing.T) {
+	schemaJSON := []byte(`{
+		"type": "object",
+		"properties": {
+			"id": {"type": "string", "format": "uuid"},
+			"age": {"type": "integer", "minimum": 18, "maximum": 18},
+			"role": {"type": "string", "enum": ["admin", "user"]},
+			"tags": {"type": "array", "minItems": 2, "maxItems": 2, "items": {"type": "string", "enum": ["a"]}}
+		}
+	}`)
+
+	g, err := NewSchemaGeneratorFromFile(schemaJSON, testRand())
+	if err != nil {
+		t.Fatalf("NewSchemaGeneratorFromFile unexpected error: %v", err)
+	}
+
+	doc, err := g.Generate()
+	if err != nil {
+		t.Fatalf("Generate unexpected error: %v", err)
+	}
+
+	if doc["age"] != 18 {
+		t.Errorf("expected age pinned to 18 by minimum/maximum, got %v", doc["age"])
+	}
+	role, ok := doc["role"].(string)
+	if !ok || (role != "admin" && role != "user") {
+		t.Errorf("expected role to be one of the enum values, got %v", doc["role"])
+	}
+	tags, ok := doc["tags"].([]interface{})
+	if !ok || len(tags) != 2 {
+		t.Errorf("expected tags to have exactly 2 items, got %v", doc["tags"])
+	}
+}
+
+func TestSchemaGeneratorRejectsNonObjectRoot(t *testing.T) {
+	g, err := NewSchemaGeneratorFromFile([]byte(`{"type": "string"}`), testRand())
+	if err != nil {
+		t.Fatalf("NewSchemaGeneratorFromFile unexpected error: %v", err)
+	}
+	if _, err := g.Generate(); err == nil {
+		t.Error("expected an error for a non-object schema root")
+	}
+}
+
+func TestGenerateFromSchemaOneOf(t *testing.T) {
+	schema := &jsonSchema{
+		OneOf: []*jsonSchema{
+			{Type: "string", Enum: []interface{}{"x"}},
+			{Type: "integer", Minimum: floatPtr(5), Maximum: floatPtr(5)},
+		},
+	}
+
+	value, err := generateFromSchema(schema, testRand())
+	if err != nil {
+		t.Fatalf("generateFromSchema unexpected error: %v", err)
+	}
+	if value != "x" && value != 5 {
+		t.Errorf("expected a value from one of the oneOf branches, got %v", value)
+	}
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,71 @@
+package gorandomify
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// LoadTemplate decodes a template file into the generic map structure
+// GenerateFromMap walks, auto-detecting the format from path's extension:
+// ".yaml"/".yml", ".hcl", or the existing ".json" default.
+func LoadTemplate(path string, data []byte) (map[string]interface{}, error) {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		return doc, nil
+	case ".hcl":
+		return decodeHCL(data, path)
+	case ".json", "":
+		var doc map[string]interface{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("unsupported template extension: %s", ext)
+	}
+}
+
+// decodeHCL converts the top-level attributes of an HCL file into a plain
+// map[string]interface{} by evaluating each attribute's expression and
+// round-tripping it through cty's JSON representation.
+func decodeHCL(data []byte, filename string) (map[string]interface{}, error) {
+	parser := hclparse.NewParser()
+	file, diags := parser.ParseHCL(data, filename)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	attrs, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	doc := make(map[string]interface{}, len(attrs))
+	for name, attr := range attrs {
+		value, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		encoded, err := ctyjson.Marshal(value, value.Type())
+		if err != nil {
+			return nil, err
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			return nil, err
+		}
+		doc[name] = decoded
+	}
+	return doc, nil
+}
@@ -0,0 +1,29 @@
+package gorandomify
+
+import "testing"
+
+func TestLoadTemplateJSON(t *testing.T) {
+	doc, err := LoadTemplate("plan.json", []byte(`{"name": "$NAME"}`))
+	if err != nil {
+		t.Fatalf("LoadTemplate unexpected error: %v", err)
+	}
+	if doc["name"] != "$NAME" {
+		t.Errorf("expected name token to survive decoding, got %v", doc["name"])
+	}
+}
+
+func TestLoadTemplateYAML(t *testing.T) {
+	doc, err := LoadTemplate("plan.yaml", []byte("name: \"$NAME\"\nage: \"$INT(1:10)\"\n"))
+	if err != nil {
+		t.Fatalf("LoadTemplate unexpected error: %v", err)
+	}
+	if doc["name"] != "$NAME" || doc["age"] != "$INT(1:10)" {
+		t.Errorf("expected decoded YAML fields, got %v", doc)
+	}
+}
+
+func TestLoadTemplateUnsupportedExtension(t *testing.T) {
+	if _, err := LoadTemplate("plan.toml", []byte("name = \"$NAME\"")); err == nil {
+		t.Error("expected an error for an unsupported template extension")
+	}
+}
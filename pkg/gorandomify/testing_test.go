@@ -0,0 +1,7 @@
+package gorandomify
+
+import "math/rand"
+
+func testRand() *rand.Rand {
+	return rand.New(rand.NewSource(1))
+}
@@ -0,0 +1,227 @@
+package gorandomify
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// generationRegistry accumulates state across the two traversal passes that
+// template generation needs: a path -> generated-value map populated during
+// the first pass, and the set of $REF tokens that must be resolved against
+// it once the first pass completes. It's shared by pointer across every
+// generationContext derived from the same run.
+type generationRegistry struct {
+	values      map[string]interface{}
+	pendingRefs []pendingRef
+	updaters    map[string]Updater
+	rng         *rand.Rand
+}
+
+// generationContext is the per-call view into a generationRegistry. Most
+// traversal passes through data/copiedData map assignment; arraySetter
+// lets the array traversal reuse the same parseAndUpdate logic by writing
+// back through a slice index instead.
+type generationContext struct {
+	reg         *generationRegistry
+	arraySetter func(interface{})
+}
+
+// pendingRef is a $REF(path) token discovered during the first pass. setter
+// writes the resolved value back into the container (map key or array
+// index) the token was found in.
+type pendingRef struct {
+	sourcePath string
+	targetPath string
+	setter     func(interface{})
+}
+
+func newGenerationContext(updaters map[string]Updater, rng *rand.Rand) *generationContext {
+	return &generationContext{reg: &generationRegistry{values: make(map[string]interface{}), updaters: updaters, rng: rng}}
+}
+
+// resolveRefs runs the second pass: every $REF token is looked up against
+// the values collected during the first pass. $REF tokens never populate
+// values themselves, so a $REF pointing at another $REF, at itself, or at a
+// path that doesn't exist all fail the same way: the lookup misses.
+func (ctx *generationContext) resolveRefs() error {
+	for _, ref := range ctx.reg.pendingRefs {
+		val, ok := ctx.reg.values[ref.targetPath]
+		if !ok {
+			return fmt.Errorf("unresolved $REF(%s) at %s: cycle or forward reference", ref.targetPath, ref.sourcePath)
+		}
+		ref.setter(val)
+	}
+	return nil
+}
+
+func joinPath(parent, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
+
+func traverseAndUpdate(data, copiedData map[string]interface{}, path string, ctx *generationContext) {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := data[key]
+		childPath := joinPath(path, key)
+		switch v := value.(type) {
+		case map[string]interface{}:
+			traverseAndUpdate(v, copiedData[key].(map[string]interface{}), childPath, ctx)
+		case []interface{}:
+			expanded, err := expandArrayDirective(v, ctx.reg.rng)
+			if err != nil {
+				continue
+			}
+			if expanded != nil {
+				v = expanded
+				data[key] = v
+				copiedData[key] = v
+			}
+			traverseArray(v, childPath, ctx)
+		case string:
+			parseAndUpdate(key, v, data, copiedData, childPath, ctx)
+		}
+	}
+}
+
+// traverseArray walks the elements of a (possibly just-expanded) array in
+// place, recursing into nested maps/arrays and resolving string tokens.
+func traverseArray(arr []interface{}, path string, ctx *generationContext) {
+	for i, elem := range arr {
+		childPath := fmt.Sprintf("%s[%d]", path, i)
+		switch v := elem.(type) {
+		case map[string]interface{}:
+			traverseAndUpdate(v, v, childPath, ctx)
+		case []interface{}:
+			expanded, err := expandArrayDirective(v, ctx.reg.rng)
+			if err != nil {
+				continue
+			}
+			if expanded != nil {
+				v = expanded
+				arr[i] = v
+			}
+			traverseArray(v, childPath, ctx)
+		case string:
+			idx := i
+			parseAndUpdate(childPath, v, nil, nil, childPath, ctx.withArraySetter(func(val interface{}) { arr[idx] = val }))
+		}
+	}
+}
+
+// withArraySetter returns a copy of ctx whose parseAndUpdate call (for a
+// generated value or a resolved $REF) writes through setter instead of a map
+// assignment. It's a
+// thin wrapper so traverseArray and the map-based traversal can share
+// parseAndUpdate.
+func (ctx *generationContext) withArraySetter(setter func(interface{})) *generationContext {
+	return &generationContext{reg: ctx.reg, arraySetter: setter}
+}
+
+var arrayDirectivePattern = regexp.MustCompile(`^\$(REPEAT|ARRAY)\((\d+)(?::(\d+))?\)$`)
+
+// expandArrayDirective checks whether arr is a `[directive, template, ...]`
+// pair such as `["$REPEAT(3)", {...}]` or `["$ARRAY(3:10)", {...}]`. If so,
+// it returns a new slice with the template cloned the requested number of
+// times (a fixed count for $REPEAT, a random count in range for $ARRAY).
+// It returns a nil slice (and nil error) when arr isn't a directive array.
+func expandArrayDirective(arr []interface{}, r *rand.Rand) ([]interface{}, error) {
+	if len(arr) < 2 {
+		return nil, nil
+	}
+	directive, ok := arr[0].(string)
+	if !ok {
+		return nil, nil
+	}
+	matches := arrayDirectivePattern.FindStringSubmatch(directive)
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	kind, template := matches[1], arr[1]
+	var count int
+	switch kind {
+	case "REPEAT":
+		count, _ = strconv.Atoi(matches[2])
+	case "ARRAY":
+		lower, _ := strconv.Atoi(matches[2])
+		if matches[3] == "" {
+			return nil, fmt.Errorf("$ARRAY requires a min:max range")
+		}
+		upper, _ := strconv.Atoi(matches[3])
+		if lower > upper {
+			return nil, fmt.Errorf("invalid ARRAY range: %s", directive)
+		}
+		count = lower + r.Intn(upper-lower+1)
+	}
+
+	cloned := make([]interface{}, count)
+	for i := range cloned {
+		cloned[i] = deepCopyValue(template)
+	}
+	return cloned, nil
+}
+
+// deepCopyValue recursively clones maps and slices so that cloned array
+// elements can be generated independently of one another.
+func deepCopyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			copied[key] = deepCopyValue(val)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, val := range v {
+			copied[i] = deepCopyValue(val)
+		}
+		return copied
+	default:
+		return v
+	}
+}
+
+func copyData(original map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{})
+	for key, value := range original {
+		copied[key] = value
+	}
+	return copied
+}
+
+var refPattern = regexp.MustCompile(`^\$REF\(([^)]+)\)$`)
+
+func parseAndUpdate(key, value string, data, copiedData map[string]interface{}, path string, ctx *generationContext) {
+	setter := func(val interface{}) {
+		if ctx.arraySetter != nil {
+			ctx.arraySetter(val)
+			return
+		}
+		data[key] = val
+		copiedData[key] = val
+	}
+
+	if matches := refPattern.FindStringSubmatch(value); len(matches) != 0 {
+		ctx.reg.pendingRefs = append(ctx.reg.pendingRefs, pendingRef{sourcePath: path, targetPath: matches[1], setter: setter})
+		return
+	}
+
+	if updater := getUpdater(value, ctx.reg.updaters); updater != nil {
+		if newVal, err := updater.Update(value, ctx.reg.rng); err == nil {
+			setter(newVal)
+			ctx.reg.values[path] = newVal
+		}
+	}
+}
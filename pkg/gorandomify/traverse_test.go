@@ -0,0 +1,156 @@
+package gorandomify
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+)
+
+func TestCopyData(t *testing.T) {
+	original := map[string]interface{}{
+		"key1": "value1",
+		"key2": "value2",
+	}
+	copied := copyData(original)
+
+	if len(copied) != len(original) {
+		t.Errorf("Expected copied map length %d, got %d", len(original), len(copied))
+	}
+
+	for key, value := range original {
+		if copied[key] != value {
+			t.Errorf("Expected value %v for key %s, got %v", value, key, copied[key])
+		}
+	}
+}
+
+func TestParseAndUpdate(t *testing.T) {
+	data := map[string]interface{}{
+		"key1": "$UUID",
+		"key2": "$INT(1:10)",
+		"key3": "$CHAR(5)",
+	}
+	copiedData := copyData(data)
+	traverseAndUpdate(data, copiedData, "", newGenerationContext(defaultUpdaters(), testRand()))
+
+	if data["key1"] == "$UUID" {
+		t.Error("Expected key1 to be updated with UUID, but it wasn't")
+	}
+
+	if data["key2"] == "$INT(1:10)" {
+		t.Error("Expected key2 to be updated with an integer, but it wasn't")
+	}
+
+	if data["key3"] == "$CHAR(5)" {
+		t.Error("Expected key3 to be updated with a random string, but it wasn't")
+	}
+}
+
+func TestTraverseAndUpdateArray(t *testing.T) {
+	data := map[string]interface{}{
+		"tags": []interface{}{"$UUID", "$UUID"},
+	}
+	copiedData := copyData(data)
+	traverseAndUpdate(data, copiedData, "", newGenerationContext(defaultUpdaters(), testRand()))
+
+	tags := data["tags"].([]interface{})
+	for i, tag := range tags {
+		if tag == "$UUID" {
+			t.Errorf("expected tags[%d] to be updated with a UUID, but it wasn't", i)
+		}
+	}
+}
+
+func TestExpandArrayDirectiveRepeat(t *testing.T) {
+	data := map[string]interface{}{
+		"items": []interface{}{"$REPEAT(3)", map[string]interface{}{"id": "$UUID"}},
+	}
+	copiedData := copyData(data)
+	traverseAndUpdate(data, copiedData, "", newGenerationContext(defaultUpdaters(), testRand()))
+
+	items := data["items"].([]interface{})
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(items))
+	}
+
+	seen := map[string]bool{}
+	for _, item := range items {
+		id := item.(map[string]interface{})["id"].(string)
+		if seen[id] {
+			t.Error("expected each cloned item to be independently generated, got a duplicate id")
+		}
+		seen[id] = true
+	}
+}
+
+func TestExpandArrayDirectiveArrayRange(t *testing.T) {
+	data := map[string]interface{}{
+		"items": []interface{}{"$ARRAY(3:3)", map[string]interface{}{"id": "$UUID"}},
+	}
+	copiedData := copyData(data)
+	traverseAndUpdate(data, copiedData, "", newGenerationContext(defaultUpdaters(), testRand()))
+
+	if len(data["items"].([]interface{})) != 3 {
+		t.Errorf("expected $ARRAY(3:3) to produce exactly 3 items, got %d", len(data["items"].([]interface{})))
+	}
+}
+
+func TestResolveRefs(t *testing.T) {
+	data := map[string]interface{}{
+		"user": map[string]interface{}{
+			"id": "$UUID",
+		},
+		"order": map[string]interface{}{
+			"userId": "$REF(user.id)",
+		},
+	}
+	copiedData := copyData(data)
+	ctx := newGenerationContext(defaultUpdaters(), testRand())
+	traverseAndUpdate(data, copiedData, "", ctx)
+	if err := ctx.resolveRefs(); err != nil {
+		t.Fatalf("resolveRefs() unexpected error: %v", err)
+	}
+
+	userID := data["user"].(map[string]interface{})["id"]
+	orderUserID := data["order"].(map[string]interface{})["userId"]
+	if userID != orderUserID {
+		t.Errorf("expected $REF(user.id) to resolve to %v, got %v", userID, orderUserID)
+	}
+}
+
+func TestResolveRefsCycleError(t *testing.T) {
+	data := map[string]interface{}{
+		"a": "$REF(b)",
+		"b": "$REF(a)",
+	}
+	copiedData := copyData(data)
+	ctx := newGenerationContext(defaultUpdaters(), testRand())
+	traverseAndUpdate(data, copiedData, "", ctx)
+	if err := ctx.resolveRefs(); err == nil {
+		t.Error("expected an error resolving a $REF cycle, got nil")
+	}
+}
+
+func TestSeededGenerationIsDeterministic(t *testing.T) {
+	template := map[string]interface{}{
+		"id":   "$UUID",
+		"age":  "$INT(1:100)",
+		"code": "$CHAR(8)",
+	}
+
+	generate := func() map[string]interface{} {
+		data := copyData(template)
+		copied := copyData(data)
+		traverseAndUpdate(data, copied, "", newGenerationContext(defaultUpdaters(), rand.New(rand.NewSource(42))))
+		return copied
+	}
+
+	first := generate()
+	second := generate()
+
+	firstJSON, _ := json.Marshal(first)
+	secondJSON, _ := json.Marshal(second)
+	if string(firstJSON) != string(secondJSON) {
+		t.Errorf("expected identical output for the same seed, got %s and %s", firstJSON, secondJSON)
+	}
+}
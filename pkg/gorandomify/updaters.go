@@ -0,0 +1,368 @@
+package gorandomify
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Updater resolves a single template token (e.g. "$NAME" or "$INT(1:10)")
+// into a generated value. Register custom ones with Generator.RegisterUpdater
+// to add tokens of your own without forking the package.
+type Updater interface {
+	Update(value string, r *rand.Rand) (interface{}, error)
+}
+
+type uuidUpdater struct{}
+type intUpdater struct{}
+type charUpdater struct{}
+type nameUpdater struct{}
+type emailUpdater struct{}
+type addressUpdater struct{}
+type phoneUpdater struct{}
+type dateUpdater struct{}
+type loremUpdater struct{}
+type ipUpdater struct{}
+type urlUpdater struct{}
+type creditCardUpdater struct{}
+
+func (u uuidUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	id, err := uuid.NewRandomFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return id.String(), nil
+}
+
+func (u intUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return getInt(value, r)
+}
+
+func (u charUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return randomString(value, r)
+}
+
+func (u nameUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return randomName(r), nil
+}
+
+func (u emailUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return randomEmail(r), nil
+}
+
+func (u addressUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return randomAddress(tokenParam(value), r)
+}
+
+func (u phoneUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return randomPhone(tokenParam(value), r)
+}
+
+func (u dateUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return randomDate(tokenParam(value), r)
+}
+
+func (u loremUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return randomLorem(tokenParam(value), r)
+}
+
+func (u ipUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return randomIP(tokenParam(value), r)
+}
+
+func (u urlUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return randomURL(r), nil
+}
+
+func (u creditCardUpdater) Update(value string, r *rand.Rand) (interface{}, error) {
+	return randomCreditCard(tokenParam(value), r)
+}
+
+// defaultUpdaters returns the built-in token registry that every Generator
+// starts with; RegisterUpdater adds to (or overrides) a copy of this map.
+func defaultUpdaters() map[string]Updater {
+	return map[string]Updater{
+		"uuid":       uuidUpdater{},
+		"int":        intUpdater{},
+		"char":       charUpdater{},
+		"name":       nameUpdater{},
+		"email":      emailUpdater{},
+		"address":    addressUpdater{},
+		"phone":      phoneUpdater{},
+		"date":       dateUpdater{},
+		"lorem":      loremUpdater{},
+		"ip":         ipUpdater{},
+		"url":        urlUpdater{},
+		"creditcard": creditCardUpdater{},
+	}
+}
+
+// tokenPattern matches a template token such as "$INT(1:10)" or "$UUID",
+// splitting it into the token name ("INT") and its raw parameter string
+// ("1:10"), if any.
+var tokenPattern = regexp.MustCompile(`^\$([A-Z]+)(?:\(([^)]*)\))?$`)
+
+// tokenParam returns the raw parameter portion of a template token, or ""
+// if the token takes no parameters.
+func tokenParam(value string) string {
+	matches := tokenPattern.FindStringSubmatch(value)
+	if len(matches) == 0 {
+		return ""
+	}
+	return matches[2]
+}
+
+func getUpdater(value string, updaters map[string]Updater) Updater {
+	matches := tokenPattern.FindStringSubmatch(value)
+	if len(matches) == 0 {
+		return nil
+	}
+	return updaters[strings.ToLower(matches[1])]
+}
+
+func getInt(value string, r *rand.Rand) (int, error) {
+	param := tokenParam(value)
+	if param == "" {
+		return r.Intn(10000), nil
+	}
+
+	bounds := strings.SplitN(param, ":", 2)
+	if len(bounds) != 2 {
+		return r.Intn(10000), nil
+	}
+
+	lower, err1 := strconv.Atoi(bounds[0])
+	upper, err2 := strconv.Atoi(bounds[1])
+	if err1 != nil || err2 != nil || lower > upper {
+		return 0, fmt.Errorf("invalid INT range")
+	}
+
+	return r.Intn(upper-lower+1) + lower, nil
+}
+
+func randomString(value string, r *rand.Rand) (string, error) {
+	length := 10
+	if param := tokenParam(value); param != "" {
+		if l, err := strconv.Atoi(param); err == nil {
+			length = l
+		}
+	}
+	return getRandomStrNlen(length, r), nil
+}
+
+var firstNames = []string{
+	"James", "Mary", "Robert", "Patricia", "John", "Jennifer", "Michael",
+	"Linda", "William", "Elizabeth", "David", "Barbara", "Richard", "Susan",
+	"Joseph", "Jessica", "Thomas", "Sarah", "Charles", "Karen",
+}
+
+var lastNames = []string{
+	"Smith", "Johnson", "Williams", "Brown", "Jones", "Garcia", "Miller",
+	"Davis", "Rodriguez", "Martinez", "Hernandez", "Lopez", "Gonzalez",
+	"Wilson", "Anderson", "Thomas", "Taylor", "Moore", "Jackson", "Martin",
+}
+
+var emailDomains = []string{
+	"example.com", "mail.com", "test.org", "sample.net", "demo.io",
+}
+
+var cityNames = []string{
+	"Springfield", "Riverside", "Franklin", "Greenville", "Clinton",
+	"Madison", "Georgetown", "Salem", "Fairview", "Ashland",
+}
+
+var streetNames = []string{
+	"Main St", "Oak Ave", "Maple Dr", "Cedar Ln", "Elm St", "Park Rd",
+	"Sunset Blvd", "Lake St", "Hill Ave", "Church St",
+}
+
+var loremWords = []string{
+	"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing",
+	"elit", "sed", "do", "eiusmod", "tempor", "incididunt", "ut", "labore",
+	"et", "dolore", "magna", "aliqua", "enim", "minim", "veniam", "quis",
+	"nostrud", "exercitation",
+}
+
+func randomName(r *rand.Rand) string {
+	return firstNames[r.Intn(len(firstNames))] + " " + lastNames[r.Intn(len(lastNames))]
+}
+
+func randomEmail(r *rand.Rand) string {
+	first := firstNames[r.Intn(len(firstNames))]
+	last := lastNames[r.Intn(len(lastNames))]
+	domain := emailDomains[r.Intn(len(emailDomains))]
+	return strings.ToLower(first + "." + last + strconv.Itoa(r.Intn(100)) + "@" + domain)
+}
+
+// randomAddress generates a street address, or just the requested component
+// ("city", "street", "zip") when param names one.
+func randomAddress(param string, r *rand.Rand) (string, error) {
+	city := cityNames[r.Intn(len(cityNames))]
+	switch param {
+	case "":
+		street := fmt.Sprintf("%d %s", r.Intn(9999)+1, streetNames[r.Intn(len(streetNames))])
+		zip := fmt.Sprintf("%05d", r.Intn(100000))
+		return fmt.Sprintf("%s, %s %s", street, city, zip), nil
+	case "city":
+		return city, nil
+	case "street":
+		return fmt.Sprintf("%d %s", r.Intn(9999)+1, streetNames[r.Intn(len(streetNames))]), nil
+	case "zip":
+		return fmt.Sprintf("%05d", r.Intn(100000)), nil
+	}
+	return "", fmt.Errorf("unsupported ADDRESS parameter: %s", param)
+}
+
+// randomPhone generates a phone number. param selects the format, currently
+// "E164" (e.g. "+12025550114") or the default US-style "(202) 555-0114".
+func randomPhone(param string, r *rand.Rand) (string, error) {
+	area := r.Intn(800) + 200
+	exchange := r.Intn(800) + 200
+	line := r.Intn(10000)
+
+	switch param {
+	case "", "NATIONAL":
+		return fmt.Sprintf("(%d) %d-%04d", area, exchange, line), nil
+	case "E164":
+		return fmt.Sprintf("+1%d%d%04d", area, exchange, line), nil
+	}
+	return "", fmt.Errorf("unsupported PHONE format: %s", param)
+}
+
+// defaultDateFrom and defaultDateTo bound an unparameterized $DATE token.
+// They're fixed rather than derived from time.Now() so that generation
+// stays reproducible under a fixed -seed.
+var (
+	defaultDateFrom = time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	defaultDateTo   = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// randomDate returns a random date within the inclusive range given as
+// "2020-01-01:2024-12-31". An empty param defaults to a date between 2015
+// and 2025.
+func randomDate(param string, r *rand.Rand) (string, error) {
+	const layout = "2006-01-02"
+	from := defaultDateFrom
+	to := defaultDateTo
+
+	if param != "" {
+		bounds := strings.SplitN(param, ":", 2)
+		if len(bounds) != 2 {
+			return "", fmt.Errorf("invalid DATE range: %s", param)
+		}
+		parsedFrom, err1 := time.Parse(layout, bounds[0])
+		parsedTo, err2 := time.Parse(layout, bounds[1])
+		if err1 != nil || err2 != nil || parsedFrom.After(parsedTo) {
+			return "", fmt.Errorf("invalid DATE range: %s", param)
+		}
+		from, to = parsedFrom, parsedTo
+	}
+
+	delta := to.Sub(from)
+	offset := time.Duration(r.Int63n(int64(delta) + 1))
+	return from.Add(offset).Format(layout), nil
+}
+
+// randomLorem generates lorem-ipsum text. param is "words=N" (default 10).
+func randomLorem(param string, r *rand.Rand) (string, error) {
+	n := 10
+	if param != "" {
+		matches := regexp.MustCompile(`^words=(\d+)$`).FindStringSubmatch(param)
+		if len(matches) == 0 {
+			return "", fmt.Errorf("invalid LOREM parameter: %s", param)
+		}
+		n, _ = strconv.Atoi(matches[1])
+	}
+
+	words := make([]string, n)
+	for i := range words {
+		words[i] = loremWords[r.Intn(len(loremWords))]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// randomIP generates an IP address. param selects "v4" (default) or "v6".
+func randomIP(param string, r *rand.Rand) (string, error) {
+	switch param {
+	case "", "v4":
+		return fmt.Sprintf("%d.%d.%d.%d", r.Intn(256), r.Intn(256), r.Intn(256), r.Intn(256)), nil
+	case "v6":
+		groups := make([]string, 8)
+		for i := range groups {
+			groups[i] = fmt.Sprintf("%04x", r.Intn(65536))
+		}
+		return strings.Join(groups, ":"), nil
+	}
+	return "", fmt.Errorf("unsupported IP version: %s", param)
+}
+
+func randomURL(r *rand.Rand) string {
+	schemes := []string{"https", "http"}
+	words := []string{"app", "api", "shop", "blog", "docs", "news"}
+	return fmt.Sprintf("%s://%s.%s/%s", schemes[r.Intn(len(schemes))], words[r.Intn(len(words))], emailDomains[r.Intn(len(emailDomains))], getRandomStrNlen(6, r))
+}
+
+// randomCreditCard generates a card number for the network named by param
+// ("visa", "mastercard", "amex"; default "visa"), padded with random digits
+// and completed with a valid Luhn checksum digit.
+func randomCreditCard(param string, r *rand.Rand) (string, error) {
+	network := param
+	if network == "" {
+		network = "visa"
+	}
+
+	var prefix string
+	length := 16
+	switch network {
+	case "visa":
+		prefix = "4"
+	case "mastercard":
+		prefix = "5" + strconv.Itoa(1+r.Intn(5))
+	case "amex":
+		prefix = "3" + string("47"[r.Intn(2)])
+		length = 15
+	default:
+		return "", fmt.Errorf("unsupported CREDITCARD network: %s", param)
+	}
+
+	digits := make([]byte, length)
+	copy(digits, prefix)
+	for i := len(prefix); i < length-1; i++ {
+		digits[i] = byte('0' + r.Intn(10))
+	}
+	digits[length-1] = luhnCheckDigit(digits[:length-1])
+
+	return string(digits), nil
+}
+
+// luhnCheckDigit computes the trailing digit that makes digits (given as
+// ASCII '0'-'9' bytes) pass the Luhn checksum.
+func luhnCheckDigit(digits []byte) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+func getRandomStrNlen(n int, r *rand.Rand) string {
+	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	result := make([]byte, n)
+	for i := range result {
+		result[i] = charset[r.Intn(len(charset))]
+	}
+	return string(result)
+}
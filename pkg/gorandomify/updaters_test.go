@@ -0,0 +1,158 @@
+package gorandomify
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetInt(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected error
+	}{
+		{"$INT(1:10)", nil},
+		{"$INT(10:1)", errors.New("invalid INT range")},
+		{"$INT(a:b)", errors.New("invalid INT range")},
+		{"$INT", nil},
+	}
+
+	for _, test := range tests {
+		_, err := getInt(test.input, testRand())
+		switch {
+		case test.expected == nil:
+			if err != nil {
+				t.Errorf("getInt(%s) unexpected error: %v", test.input, err)
+			}
+		case err == nil:
+			t.Errorf("getInt(%s) expected %v, got nil", test.input, test.expected)
+		case err.Error() != test.expected.Error():
+			t.Errorf("getInt(%s) expected %v, got %v", test.input, test.expected, err)
+		}
+	}
+}
+
+func TestRandomString(t *testing.T) {
+	input := "$CHAR(10)"
+	result, err := randomString(input, testRand())
+	if err != nil {
+		t.Errorf("randomString(%s) unexpected error: %v", input, err)
+	}
+
+	if len(result) != 10 {
+		t.Errorf("Expected random string length 10, got %d", len(result))
+	}
+}
+
+func TestGetUpdater(t *testing.T) {
+	updaters := defaultUpdaters()
+	tests := []struct {
+		input string
+		want  Updater
+	}{
+		{"$UUID", updaters["uuid"]},
+		{"$INT(1:10)", updaters["int"]},
+		{"$CHAR(5)", updaters["char"]},
+		{"$NAME", updaters["name"]},
+		{"$EMAIL", updaters["email"]},
+		{"$ADDRESS(city)", updaters["address"]},
+		{"$PHONE(E164)", updaters["phone"]},
+		{"$DATE(2020-01-01:2024-12-31)", updaters["date"]},
+		{"$LOREM(words=20)", updaters["lorem"]},
+		{"$IP(v4)", updaters["ip"]},
+		{"$URL", updaters["url"]},
+		{"$CREDITCARD(visa)", updaters["creditcard"]},
+		{"not-a-token", nil},
+	}
+
+	for _, test := range tests {
+		if got := getUpdater(test.input, updaters); got != test.want {
+			t.Errorf("getUpdater(%s) = %v, want %v", test.input, got, test.want)
+		}
+	}
+}
+
+func TestRandomAddress(t *testing.T) {
+	city, err := randomAddress("city", testRand())
+	if err != nil {
+		t.Errorf("randomAddress(city) unexpected error: %v", err)
+	}
+	if city == "" {
+		t.Error("expected non-empty city")
+	}
+
+	if _, err := randomAddress("bogus", testRand()); err == nil {
+		t.Error("expected error for unsupported ADDRESS parameter")
+	}
+}
+
+func TestRandomPhone(t *testing.T) {
+	phone, err := randomPhone("E164", testRand())
+	if err != nil {
+		t.Errorf("randomPhone(E164) unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(phone, "+1") {
+		t.Errorf("expected E164 phone to start with +1, got %s", phone)
+	}
+
+	if _, err := randomPhone("bogus", testRand()); err == nil {
+		t.Error("expected error for unsupported PHONE format")
+	}
+}
+
+func TestRandomDate(t *testing.T) {
+	date, err := randomDate("2020-01-01:2020-01-31", testRand())
+	if err != nil {
+		t.Errorf("randomDate unexpected error: %v", err)
+	}
+
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		t.Fatalf("randomDate returned unparsable date: %s", date)
+	}
+
+	from, _ := time.Parse("2006-01-02", "2020-01-01")
+	to, _ := time.Parse("2006-01-02", "2020-01-31")
+	if parsed.Before(from) || parsed.After(to) {
+		t.Errorf("expected date within range, got %s", date)
+	}
+
+	if _, err := randomDate("2020-01-31:2020-01-01", testRand()); err == nil {
+		t.Error("expected error for inverted DATE range")
+	}
+}
+
+func TestRandomLorem(t *testing.T) {
+	text, err := randomLorem("words=5", testRand())
+	if err != nil {
+		t.Errorf("randomLorem unexpected error: %v", err)
+	}
+	if words := strings.Fields(text); len(words) != 5 {
+		t.Errorf("expected 5 words, got %d", len(words))
+	}
+}
+
+func TestRandomIP(t *testing.T) {
+	if _, err := randomIP("v6", testRand()); err != nil {
+		t.Errorf("randomIP(v6) unexpected error: %v", err)
+	}
+
+	if _, err := randomIP("bogus", testRand()); err == nil {
+		t.Error("expected error for unsupported IP version")
+	}
+}
+
+func TestRandomCreditCard(t *testing.T) {
+	number, err := randomCreditCard("visa", testRand())
+	if err != nil {
+		t.Errorf("randomCreditCard(visa) unexpected error: %v", err)
+	}
+	if len(number) != 16 || !strings.HasPrefix(number, "4") {
+		t.Errorf("expected 16-digit visa number starting with 4, got %s", number)
+	}
+
+	if _, err := randomCreditCard("bogus", testRand()); err == nil {
+		t.Error("expected error for unsupported CREDITCARD network")
+	}
+}